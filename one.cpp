@@ -131,7 +131,7 @@ static void cliPrintHelp(const char *pn,FILE *out)
 	fprintf(out,"  -j                      - Display full raw JSON output" ZT_EOL_S);
 	fprintf(out,"  -D<path>                - ZeroTier home path for parameter auto-detect" ZT_EOL_S);
 	fprintf(out,"  -p<port>                - HTTP port (default: auto)" ZT_EOL_S);
-	fprintf(out,"  -T<token>               - Authentication token (default: auto)" ZT_EOL_S);
+	fprintf(out,"  -T<token>               - Authentication token (default: ZT_AUTH_TOKEN or auto)" ZT_EOL_S);
 	fprintf(out,ZT_EOL_S"Available commands:" ZT_EOL_S);
 	fprintf(out,"  info                    - Display status info" ZT_EOL_S);
 	fprintf(out,"  listpeers               - List all peers" ZT_EOL_S);
@@ -253,6 +253,13 @@ static int cli(int argc,char **argv)
 	if (!homeDir.length())
 		homeDir = OneService::platformDefaultHomePath();
 
+	// Auth token precedence: -T switch, then ZT_AUTH_TOKEN environment variable, then authtoken.secret lookup below
+	if (!authToken.length()) {
+		const char *envToken = getenv("ZT_AUTH_TOKEN");
+		if (envToken)
+			authToken = envToken;
+	}
+
 	// TODO: cleanup this logic
 	if ((!port)||(!authToken.length())) {
 		if (!homeDir.length()) {
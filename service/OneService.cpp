@@ -172,6 +172,14 @@ static std::string _trimString(const std::string &s)
 	return s.substr(start,end - start);
 }
 
+// Compare an auth token against a supplied value without leaking timing info about the token's contents
+static bool _authTokenEq(const std::string &token,const std::string &supplied)
+{
+	if ((token.length() == 0)||(token.length() != supplied.length()))
+		return false;
+	return Utils::secureEq(token.data(),supplied.data(),(unsigned int)token.length());
+}
+
 static void _networkToJson(nlohmann::json &nj,const ZT_VirtualNetworkConfig *nc,const std::string &portDeviceName,const OneService::NetworkSettings &localSettings)
 {
 	char tmp[256];
@@ -1200,11 +1208,11 @@ public:
 		bool isAuth = false;
 		{
 			std::map<std::string,std::string>::const_iterator ah(headers.find("x-zt1-auth"));
-			if ((ah != headers.end())&&(_authToken == ah->second)) {
+			if ((ah != headers.end())&&(_authTokenEq(_authToken,ah->second))) {
 				isAuth = true;
 			} else {
 				ah = urlArgs.find("auth");
-				if ((ah != urlArgs.end())&&(_authToken == ah->second))
+				if ((ah != urlArgs.end())&&(_authTokenEq(_authToken,ah->second)))
 					isAuth = true;
 			}
 		}